@@ -0,0 +1,386 @@
+// Copyright 2022 Giuseppe Calabrese.
+// Distributed under the terms of the ISC License.
+
+package sliceio
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// Typed binary I/O.
+//
+// These methods read and write fixed- and variable-width encodings
+// directly on the wrapper, sparing callers the reflection and
+// endianness bookkeeping that binary.Read and binary.Write require
+// on every call. They follow the same offset and error conventions
+// as Read and Write: the offset advances by whatever was
+// consumed or produced, even on failure.
+
+// ErrVarintOverflow means that a variable-length integer encoding
+// would overflow 64 bits.
+var ErrVarintOverflow = errors.New("varint overflows a 64-bit integer")
+
+// Slice returns a subslice of the next n bytes and advances the
+// offset by n. The returned slice aliases the wrapper's underlying
+// slice: mutating it mutates the wrapper's content, and it is only
+// valid until the underlying slice is replaced.
+//
+// Use ReadBytes for a copying alternative.
+//
+// Fails with ErrSeekBeforeStart if n is negative.
+// Fails with io.EOF if fewer than n bytes are available.
+func (rw *Wrapper) Slice(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, ErrSeekBeforeStart
+	}
+	if n > rw.RestLen() {
+		return nil, io.EOF
+	}
+	s := rw.slice[rw.offset : rw.offset+n]
+	rw.offset += n
+	return s, nil
+}
+
+// Variable-length integers.
+
+// WriteUvarint writes v as a variable-length unsigned integer.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteUvarint(v uint64) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	_, err := rw.Write(buf[:n])
+	return err
+}
+
+// WriteVarint writes v as a variable-length signed integer.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteVarint(v int64) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	_, err := rw.Write(buf[:n])
+	return err
+}
+
+// ReadUvarint reads a variable-length unsigned integer.
+//
+// Fails with io.EOF if the wrapper runs out of bytes before a
+// complete varint is read. Fails with ErrVarintOverflow if the
+// encoding does not fit into a uint64.
+func (rw *Wrapper) ReadUvarint() (uint64, error) {
+	var x uint64
+	var s uint
+	for i := 0; i < binary.MaxVarintLen64; i++ {
+		if rw.RestLen() == 0 {
+			return x, io.EOF
+		}
+		b := rw.slice[rw.offset]
+		rw.offset++
+		if b < 0x80 {
+			if i == binary.MaxVarintLen64-1 && b > 1 {
+				return x, ErrVarintOverflow
+			}
+			return x | uint64(b)<<s, nil
+		}
+		x |= uint64(b&0x7f) << s
+		s += 7
+	}
+	return x, ErrVarintOverflow
+}
+
+// ReadVarint reads a variable-length signed integer.
+//
+// Fails with io.EOF if the wrapper runs out of bytes before a
+// complete varint is read. Fails with ErrVarintOverflow if the
+// encoding does not fit into an int64.
+func (rw *Wrapper) ReadVarint() (int64, error) {
+	ux, err := rw.ReadUvarint()
+	if err != nil {
+		return 0, err
+	}
+	x := int64(ux >> 1)
+	if ux&1 != 0 {
+		x = ^x
+	}
+	return x, nil
+}
+
+// Fixed-width integers and floats.
+
+// WriteUint8 writes v as a single byte.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteUint8(v uint8) error {
+	_, err := rw.Write([]byte{v})
+	return err
+}
+
+// ReadUint8 reads a single byte.
+//
+// Fails with io.EOF if there is nothing left to read.
+func (rw *Wrapper) ReadUint8() (uint8, error) {
+	var buf [1]byte
+	_, err := rw.Read(buf[:])
+	return buf[0], err
+}
+
+// WriteUint16LE writes v as 2 little-endian bytes.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteUint16LE(v uint16) error {
+	var buf [2]byte
+	binary.LittleEndian.PutUint16(buf[:], v)
+	_, err := rw.Write(buf[:])
+	return err
+}
+
+// WriteUint16BE writes v as 2 big-endian bytes.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteUint16BE(v uint16) error {
+	var buf [2]byte
+	binary.BigEndian.PutUint16(buf[:], v)
+	_, err := rw.Write(buf[:])
+	return err
+}
+
+// ReadUint16LE reads 2 little-endian bytes.
+//
+// Fails with io.EOF if fewer than 2 bytes are available.
+func (rw *Wrapper) ReadUint16LE() (uint16, error) {
+	var buf [2]byte
+	if _, err := rw.Read(buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(buf[:]), nil
+}
+
+// ReadUint16BE reads 2 big-endian bytes.
+//
+// Fails with io.EOF if fewer than 2 bytes are available.
+func (rw *Wrapper) ReadUint16BE() (uint16, error) {
+	var buf [2]byte
+	if _, err := rw.Read(buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint16(buf[:]), nil
+}
+
+// WriteUint32LE writes v as 4 little-endian bytes.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteUint32LE(v uint32) error {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	_, err := rw.Write(buf[:])
+	return err
+}
+
+// WriteUint32BE writes v as 4 big-endian bytes.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteUint32BE(v uint32) error {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], v)
+	_, err := rw.Write(buf[:])
+	return err
+}
+
+// ReadUint32LE reads 4 little-endian bytes.
+//
+// Fails with io.EOF if fewer than 4 bytes are available.
+func (rw *Wrapper) ReadUint32LE() (uint32, error) {
+	var buf [4]byte
+	if _, err := rw.Read(buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(buf[:]), nil
+}
+
+// ReadUint32BE reads 4 big-endian bytes.
+//
+// Fails with io.EOF if fewer than 4 bytes are available.
+func (rw *Wrapper) ReadUint32BE() (uint32, error) {
+	var buf [4]byte
+	if _, err := rw.Read(buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(buf[:]), nil
+}
+
+// WriteUint64LE writes v as 8 little-endian bytes.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteUint64LE(v uint64) error {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	_, err := rw.Write(buf[:])
+	return err
+}
+
+// WriteUint64BE writes v as 8 big-endian bytes.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteUint64BE(v uint64) error {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	_, err := rw.Write(buf[:])
+	return err
+}
+
+// ReadUint64LE reads 8 little-endian bytes.
+//
+// Fails with io.EOF if fewer than 8 bytes are available.
+func (rw *Wrapper) ReadUint64LE() (uint64, error) {
+	var buf [8]byte
+	if _, err := rw.Read(buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(buf[:]), nil
+}
+
+// ReadUint64BE reads 8 big-endian bytes.
+//
+// Fails with io.EOF if fewer than 8 bytes are available.
+func (rw *Wrapper) ReadUint64BE() (uint64, error) {
+	var buf [8]byte
+	if _, err := rw.Read(buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
+
+// WriteFloat32LE writes v as 4 little-endian bytes, IEEE 754 encoded.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteFloat32LE(v float32) error {
+	return rw.WriteUint32LE(math.Float32bits(v))
+}
+
+// WriteFloat32BE writes v as 4 big-endian bytes, IEEE 754 encoded.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteFloat32BE(v float32) error {
+	return rw.WriteUint32BE(math.Float32bits(v))
+}
+
+// ReadFloat32LE reads 4 little-endian, IEEE 754 encoded bytes.
+//
+// Fails with io.EOF if fewer than 4 bytes are available.
+func (rw *Wrapper) ReadFloat32LE() (float32, error) {
+	bits, err := rw.ReadUint32LE()
+	return math.Float32frombits(bits), err
+}
+
+// ReadFloat32BE reads 4 big-endian, IEEE 754 encoded bytes.
+//
+// Fails with io.EOF if fewer than 4 bytes are available.
+func (rw *Wrapper) ReadFloat32BE() (float32, error) {
+	bits, err := rw.ReadUint32BE()
+	return math.Float32frombits(bits), err
+}
+
+// WriteFloat64LE writes v as 8 little-endian bytes, IEEE 754 encoded.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteFloat64LE(v float64) error {
+	return rw.WriteUint64LE(math.Float64bits(v))
+}
+
+// WriteFloat64BE writes v as 8 big-endian bytes, IEEE 754 encoded.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteFloat64BE(v float64) error {
+	return rw.WriteUint64BE(math.Float64bits(v))
+}
+
+// ReadFloat64LE reads 8 little-endian, IEEE 754 encoded bytes.
+//
+// Fails with io.EOF if fewer than 8 bytes are available.
+func (rw *Wrapper) ReadFloat64LE() (float64, error) {
+	bits, err := rw.ReadUint64LE()
+	return math.Float64frombits(bits), err
+}
+
+// ReadFloat64BE reads 8 big-endian, IEEE 754 encoded bytes.
+//
+// Fails with io.EOF if fewer than 8 bytes are available.
+func (rw *Wrapper) ReadFloat64BE() (float64, error) {
+	bits, err := rw.ReadUint64BE()
+	return math.Float64frombits(bits), err
+}
+
+// Length-prefixed bytes and strings.
+
+// WriteBytes writes b preceded by its length as a uvarint.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteBytes(b []byte) error {
+	if err := rw.WriteUvarint(uint64(len(b))); err != nil {
+		return err
+	}
+	_, err := rw.Write(b)
+	return err
+}
+
+// ReadBytes reads a uvarint length followed by that many bytes,
+// and returns a copy of them.
+//
+// Fails with io.EOF if the length or the bytes it announces run
+// past the end of the wrapper.
+func (rw *Wrapper) ReadBytes() ([]byte, error) {
+	n, err := rw.ReadUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(rw.RestLen()) {
+		return nil, io.EOF
+	}
+	s, err := rw.Slice(int(n))
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, n)
+	copy(buf, s)
+	return buf, nil
+}
+
+// WriteString writes s preceded by its length as a uvarint.
+//
+// Fails with ErrCapacity if there is not enough room.
+func (rw *Wrapper) WriteString(s string) error {
+	if err := rw.WriteUvarint(uint64(len(s))); err != nil {
+		return err
+	}
+	_, err := rw.Write([]byte(s))
+	return err
+}
+
+// ReadString reads a uvarint length followed by that many bytes,
+// and returns them as a string.
+//
+// Fails with io.EOF if the length or the bytes it announces run
+// past the end of the wrapper.
+func (rw *Wrapper) ReadString() (string, error) {
+	b, err := rw.ReadBytes()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Self-encoding types.
+
+// WriteSelf writes w to the wrapper by calling w.WriteTo(rw).
+func (rw *Wrapper) WriteSelf(w io.WriterTo) (int64, error) {
+	return w.WriteTo(rw)
+}
+
+// ReadSelf reads into r from the wrapper by calling r.ReadFrom(rw).
+func (rw *Wrapper) ReadSelf(r io.ReaderFrom) (int64, error) {
+	return r.ReadFrom(rw)
+}
@@ -0,0 +1,202 @@
+// Copyright 2022 Giuseppe Calabrese.
+// Distributed under the terms of the ISC License.
+
+package sliceio_test
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/giucal/sliceio"
+)
+
+func TestUvarintRoundTrip(t *testing.T) {
+	cases := []uint64{0, 1, 127, 128, 300, 1<<63 - 1, math.MaxUint64}
+	for _, v := range cases {
+		w := sliceio.New(10)
+		if err := w.WriteUvarint(v); err != nil {
+			t.Fatalf("WriteUvarint(%d): %v", v, err)
+		}
+		w.Rewind()
+		got, err := w.ReadUvarint()
+		if err != nil {
+			t.Fatalf("ReadUvarint after writing %d: %v", v, err)
+		}
+		if got != v {
+			t.Errorf("got %d, want %d", got, v)
+		}
+	}
+}
+
+func TestVarintRoundTrip(t *testing.T) {
+	cases := []int64{0, 1, -1, 127, -127, math.MaxInt64, math.MinInt64}
+	for _, v := range cases {
+		w := sliceio.New(10)
+		if err := w.WriteVarint(v); err != nil {
+			t.Fatalf("WriteVarint(%d): %v", v, err)
+		}
+		w.Rewind()
+		got, err := w.ReadVarint()
+		if err != nil {
+			t.Fatalf("ReadVarint after writing %d: %v", v, err)
+		}
+		if got != v {
+			t.Errorf("got %d, want %d", got, v)
+		}
+	}
+}
+
+// TestReadUvarintOverflow checks that more than binary.MaxVarintLen64
+// continuation bytes fail with ErrVarintOverflow instead of silently
+// decoding, and that only MaxVarintLen64 bytes are consumed.
+func TestReadUvarintOverflow(t *testing.T) {
+	buf := make([]byte, 13)
+	for i := 0; i < 12; i++ {
+		buf[i] = 0xFF // continuation bit set, never terminates
+	}
+	buf[12] = 0x01 // would terminate the varint if ever reached
+
+	w := sliceio.Wrap(buf, 0)
+	_, err := w.ReadUvarint()
+	if err != sliceio.ErrVarintOverflow {
+		t.Fatalf("got err %v, want ErrVarintOverflow", err)
+	}
+	if w.Offset() != 10 {
+		t.Fatalf("got offset %d, want 10 (exactly MaxVarintLen64 bytes consumed)", w.Offset())
+	}
+}
+
+func TestBytesAndStringRoundTrip(t *testing.T) {
+	w := sliceio.New(64)
+	if err := w.WriteBytes([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteString("world"); err != nil {
+		t.Fatal(err)
+	}
+	w.Rewind()
+
+	b, err := w.ReadBytes()
+	if err != nil || string(b) != "hello" {
+		t.Fatalf("ReadBytes() = %q, %v, want %q, nil", b, err, "hello")
+	}
+	s, err := w.ReadString()
+	if err != nil || s != "world" {
+		t.Fatalf("ReadString() = %q, %v, want %q, nil", s, err, "world")
+	}
+}
+
+func TestFixedWidthRoundTrip(t *testing.T) {
+	w := sliceio.New(1 + 2 + 2 + 4 + 4 + 8 + 8 + 4 + 4 + 8 + 8)
+
+	if err := w.WriteUint8(0xAB); err != nil {
+		t.Fatalf("WriteUint8: %v", err)
+	}
+	if err := w.WriteUint16LE(0x1234); err != nil {
+		t.Fatalf("WriteUint16LE: %v", err)
+	}
+	if err := w.WriteUint16BE(0x1234); err != nil {
+		t.Fatalf("WriteUint16BE: %v", err)
+	}
+	if err := w.WriteUint32LE(0x12345678); err != nil {
+		t.Fatalf("WriteUint32LE: %v", err)
+	}
+	if err := w.WriteUint32BE(0x12345678); err != nil {
+		t.Fatalf("WriteUint32BE: %v", err)
+	}
+	if err := w.WriteUint64LE(0x1234567890ABCDEF); err != nil {
+		t.Fatalf("WriteUint64LE: %v", err)
+	}
+	if err := w.WriteUint64BE(0x1234567890ABCDEF); err != nil {
+		t.Fatalf("WriteUint64BE: %v", err)
+	}
+	if err := w.WriteFloat32LE(3.5); err != nil {
+		t.Fatalf("WriteFloat32LE: %v", err)
+	}
+	if err := w.WriteFloat32BE(3.5); err != nil {
+		t.Fatalf("WriteFloat32BE: %v", err)
+	}
+	if err := w.WriteFloat64LE(2.718281828); err != nil {
+		t.Fatalf("WriteFloat64LE: %v", err)
+	}
+	if err := w.WriteFloat64BE(2.718281828); err != nil {
+		t.Fatalf("WriteFloat64BE: %v", err)
+	}
+
+	w.Rewind()
+
+	if v, err := w.ReadUint8(); err != nil || v != 0xAB {
+		t.Fatalf("ReadUint8() = %#x, %v, want 0xAB, nil", v, err)
+	}
+	if v, err := w.ReadUint16LE(); err != nil || v != 0x1234 {
+		t.Fatalf("ReadUint16LE() = %#x, %v, want 0x1234, nil", v, err)
+	}
+	if v, err := w.ReadUint16BE(); err != nil || v != 0x1234 {
+		t.Fatalf("ReadUint16BE() = %#x, %v, want 0x1234, nil", v, err)
+	}
+	if v, err := w.ReadUint32LE(); err != nil || v != 0x12345678 {
+		t.Fatalf("ReadUint32LE() = %#x, %v, want 0x12345678, nil", v, err)
+	}
+	if v, err := w.ReadUint32BE(); err != nil || v != 0x12345678 {
+		t.Fatalf("ReadUint32BE() = %#x, %v, want 0x12345678, nil", v, err)
+	}
+	if v, err := w.ReadUint64LE(); err != nil || v != 0x1234567890ABCDEF {
+		t.Fatalf("ReadUint64LE() = %#x, %v, want 0x1234567890ABCDEF, nil", v, err)
+	}
+	if v, err := w.ReadUint64BE(); err != nil || v != 0x1234567890ABCDEF {
+		t.Fatalf("ReadUint64BE() = %#x, %v, want 0x1234567890ABCDEF, nil", v, err)
+	}
+	if v, err := w.ReadFloat32LE(); err != nil || v != 3.5 {
+		t.Fatalf("ReadFloat32LE() = %v, %v, want 3.5, nil", v, err)
+	}
+	if v, err := w.ReadFloat32BE(); err != nil || v != 3.5 {
+		t.Fatalf("ReadFloat32BE() = %v, %v, want 3.5, nil", v, err)
+	}
+	if v, err := w.ReadFloat64LE(); err != nil || v != 2.718281828 {
+		t.Fatalf("ReadFloat64LE() = %v, %v, want 2.718281828, nil", v, err)
+	}
+	if v, err := w.ReadFloat64BE(); err != nil || v != 2.718281828 {
+		t.Fatalf("ReadFloat64BE() = %v, %v, want 2.718281828, nil", v, err)
+	}
+}
+
+func TestFixedWidthErrCapacity(t *testing.T) {
+	w := sliceio.New(1)
+	if err := w.WriteUint16LE(1); err != sliceio.ErrCapacity {
+		t.Fatalf("got err %v, want ErrCapacity", err)
+	}
+}
+
+func TestFixedWidthEOF(t *testing.T) {
+	w := sliceio.New(1)
+	if _, err := w.ReadUint32BE(); err != io.EOF {
+		t.Fatalf("got err %v, want io.EOF", err)
+	}
+}
+
+func TestWriteSelfReadSelf(t *testing.T) {
+	var in bytes.Buffer
+	in.WriteString("hello")
+
+	w := sliceio.New(5)
+	n, err := w.WriteSelf(&in)
+	if err != nil {
+		t.Fatalf("WriteSelf: %v", err)
+	}
+	if n != 5 || string(w.Content()) != "hello" {
+		t.Fatalf("got n = %d, Content() = %q, want 5, %q", n, w.Content(), "hello")
+	}
+
+	w.Rewind()
+
+	var out bytes.Buffer
+	n, err = w.ReadSelf(&out)
+	if err != nil {
+		t.Fatalf("ReadSelf: %v", err)
+	}
+	if n != 5 || out.String() != "hello" {
+		t.Fatalf("got n = %d, out = %q, want 5, %q", n, out.String(), "hello")
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Giuseppe Calabrese.
+// Distributed under the terms of the ISC License.
+
+package sliceio
+
+import "io"
+
+// Peek returns a subslice of the next n bytes without advancing the
+// offset. Like Slice, the returned slice aliases the wrapper's
+// underlying slice.
+//
+// Fails with ErrSeekBeforeStart if n is negative.
+// Fails with io.EOF if fewer than n bytes are available.
+func (rw *Wrapper) Peek(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, ErrSeekBeforeStart
+	}
+	if n > rw.RestLen() {
+		return nil, io.EOF
+	}
+	return rw.slice[rw.offset : rw.offset+n], nil
+}
+
+// Next returns a subslice of the next n bytes and advances the
+// offset by n: a zero-copy alternative to Read for callers that can
+// work against the wrapper's own backing array instead of a
+// destination buffer. It is Slice(n).
+func (rw *Wrapper) Next(n int) ([]byte, error) {
+	return rw.Slice(n)
+}
+
+// Skip advances the offset by n without reading anything.
+//
+// Fails with ErrSeekBeforeStart if n is negative.
+// Fails with io.EOF if fewer than n bytes are available.
+func (rw *Wrapper) Skip(n int) error {
+	if n < 0 {
+		return ErrSeekBeforeStart
+	}
+	if n > rw.RestLen() {
+		return io.EOF
+	}
+	rw.offset += n
+	return nil
+}
+
+// Unread moves the offset back by n, the opposite of Skip.
+//
+// Fails with ErrSeekBeforeStart if n is negative or exceeds the
+// current offset.
+func (rw *Wrapper) Unread(n int) error {
+	if n < 0 || n > rw.offset {
+		return ErrSeekBeforeStart
+	}
+	rw.offset -= n
+	return nil
+}
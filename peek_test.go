@@ -0,0 +1,87 @@
+// Copyright 2022 Giuseppe Calabrese.
+// Distributed under the terms of the ISC License.
+
+package sliceio_test
+
+import (
+	"testing"
+
+	"github.com/giucal/sliceio"
+)
+
+func TestPeekDoesNotAdvance(t *testing.T) {
+	w := sliceio.Wrap([]byte("hello"), 0)
+	b, err := w.Peek(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "hel" {
+		t.Errorf("Peek(3) = %q, want %q", b, "hel")
+	}
+	if w.Offset() != 0 {
+		t.Errorf("Peek advanced the offset to %d, want 0", w.Offset())
+	}
+}
+
+func TestPeekRejectsNegative(t *testing.T) {
+	w := sliceio.Wrap([]byte("hello"), 2)
+	if _, err := w.Peek(-1); err != sliceio.ErrSeekBeforeStart {
+		t.Fatalf("Peek(-1): got err %v, want ErrSeekBeforeStart", err)
+	}
+	if w.Offset() != 2 {
+		t.Fatalf("Peek(-1) moved the offset to %d, want unchanged 2", w.Offset())
+	}
+}
+
+func TestSliceRejectsNegative(t *testing.T) {
+	w := sliceio.Wrap([]byte("hello"), 2)
+	if _, err := w.Slice(-1); err != sliceio.ErrSeekBeforeStart {
+		t.Fatalf("Slice(-1): got err %v, want ErrSeekBeforeStart", err)
+	}
+	if w.Offset() != 2 {
+		t.Fatalf("Slice(-1) moved the offset to %d, want unchanged 2", w.Offset())
+	}
+}
+
+func TestNextRejectsNegative(t *testing.T) {
+	w := sliceio.Wrap([]byte("hello"), 2)
+	if _, err := w.Next(-1); err != sliceio.ErrSeekBeforeStart {
+		t.Fatalf("Next(-1): got err %v, want ErrSeekBeforeStart", err)
+	}
+	if w.Offset() != 2 {
+		t.Fatalf("Next(-1) moved the offset to %d, want unchanged 2", w.Offset())
+	}
+}
+
+func TestSkipRejectsNegative(t *testing.T) {
+	w := sliceio.Wrap([]byte("hello"), 2)
+	if err := w.Skip(-1); err != sliceio.ErrSeekBeforeStart {
+		t.Fatalf("Skip(-1): got err %v, want ErrSeekBeforeStart", err)
+	}
+	if w.Offset() != 2 {
+		t.Fatalf("Skip(-1) moved the offset to %d, want unchanged 2", w.Offset())
+	}
+}
+
+func TestUnreadRejectsNegative(t *testing.T) {
+	w := sliceio.Wrap([]byte("hello"), 2)
+	if err := w.Unread(-1); err != sliceio.ErrSeekBeforeStart {
+		t.Fatalf("Unread(-1): got err %v, want ErrSeekBeforeStart", err)
+	}
+	if w.Offset() != 2 {
+		t.Fatalf("Unread(-1) moved the offset to %d, want unchanged 2", w.Offset())
+	}
+}
+
+func TestUnreadBoundary(t *testing.T) {
+	w := sliceio.Wrap([]byte("hello"), 2)
+	if err := w.Unread(3); err != sliceio.ErrSeekBeforeStart {
+		t.Fatalf("Unread(3) from offset 2: got err %v, want ErrSeekBeforeStart", err)
+	}
+	if err := w.Unread(2); err != nil {
+		t.Fatalf("Unread(2) from offset 2: %v", err)
+	}
+	if w.Offset() != 0 {
+		t.Fatalf("got offset %d, want 0", w.Offset())
+	}
+}
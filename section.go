@@ -0,0 +1,31 @@
+// Copyright 2022 Giuseppe Calabrese.
+// Distributed under the terms of the ISC License.
+
+package sliceio
+
+// Section returns a wrapper bounded to the sub-range slice[off:off+n]
+// of rw's underlying slice, with its own offset starting at 0. Like
+// io.NewSectionReader, the result is a self-contained view: Read,
+// Write, Seek, ReadAt, and WriteAt on it never see bytes outside
+// [off, off+n).
+//
+// The returned wrapper aliases rw's underlying array over that
+// range: mutations through either wrapper are visible in the other.
+// rw's own offset is unaffected.
+//
+// Panics if [off, off+n) is not within [0, rw.Cap()], the same way a
+// direct slice expression would.
+func (rw *Wrapper) Section(off, n int) *Wrapper {
+	s := rw.slice[:len(rw.slice):len(rw.slice)]
+	return &Wrapper{s[off : off+n : off+n], 0}
+}
+
+// View returns an independent-offset alias of rw: a new wrapper over
+// the same bounds with its own copy of the current offset. It is
+// NewShared under a name that reads better when the point is to hand
+// out an unentangled reader or writer, e.g. two calls to
+// s.View() so one goroutine can read while another writes without
+// either clobbering the other's cursor.
+func (rw *Wrapper) View() *Wrapper {
+	return rw.NewShared()
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Giuseppe Calabrese.
+// Distributed under the terms of the ISC License.
+
+package sliceio_test
+
+import (
+	"testing"
+
+	"github.com/giucal/sliceio"
+)
+
+func TestSectionBounds(t *testing.T) {
+	buf := []byte("0123456789")
+	w := sliceio.Wrap(buf, 0)
+
+	s := w.Section(3, 4) // "3456"
+	if s.Cap() != 4 {
+		t.Fatalf("got Cap() = %d, want 4", s.Cap())
+	}
+	if s.Offset() != 0 {
+		t.Fatalf("got Offset() = %d, want 0", s.Offset())
+	}
+	if string(s.Content()) != "3456" {
+		t.Fatalf("got Content() = %q, want %q", s.Content(), "3456")
+	}
+
+	// Writing through the section must not leak outside its range.
+	if _, err := s.Write([]byte("XXXX")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if string(buf) != "012XXXX789" {
+		t.Fatalf("got buf = %q, want %q", buf, "012XXXX789")
+	}
+
+	// A 5th byte does not fit in the 4-byte section.
+	s.Rewind()
+	if _, err := s.Write([]byte("12345")); err != sliceio.ErrCapacity {
+		t.Fatalf("got err %v, want ErrCapacity", err)
+	}
+	if buf[7] != '7' {
+		t.Fatalf("write past the section end leaked into buf: %q", buf)
+	}
+}
+
+func TestSectionAliasesParent(t *testing.T) {
+	buf := []byte("hello world")
+	w := sliceio.Wrap(buf, 0)
+	s := w.Section(6, 5) // "world"
+
+	s.Write([]byte("there"))
+	if string(buf) != "hello there" {
+		t.Fatalf("got buf = %q, want %q", buf, "hello there")
+	}
+
+	// The parent's own offset is unaffected by carving out a section.
+	if w.Offset() != 0 {
+		t.Fatalf("got w.Offset() = %d, want 0", w.Offset())
+	}
+}
+
+func TestViewIndependentOffset(t *testing.T) {
+	w := sliceio.Wrap([]byte("hello"), 0)
+	reader := w.View()
+	writer := w.View()
+
+	if _, err := reader.Read(make([]byte, 3)); err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if reader.Offset() != 3 {
+		t.Fatalf("got reader.Offset() = %d, want 3", reader.Offset())
+	}
+	if writer.Offset() != 0 {
+		t.Fatalf("got writer.Offset() = %d, want 0 (unaffected by reader)", writer.Offset())
+	}
+	if w.Offset() != 0 {
+		t.Fatalf("got w.Offset() = %d, want 0 (unaffected by either view)", w.Offset())
+	}
+
+	// Views still alias the same underlying content.
+	if _, err := writer.Write([]byte("J")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if string(w.Content()) != "Jello" {
+		t.Fatalf("got w.Content() = %q, want %q", w.Content(), "Jello")
+	}
+}
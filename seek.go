@@ -0,0 +1,49 @@
+// Copyright 2022 Giuseppe Calabrese.
+// Distributed under the terms of the ISC License.
+
+package sliceio
+
+import "io"
+
+// Seek sets the read/write offset, following the io.Seeker contract,
+// so that *Wrapper satisfies io.Seeker, io.ReadSeeker, and
+// io.WriteSeeker. Unlike SeekU, negative offsets are expected input
+// (e.g. offset -1 with io.SeekEnd) rather than a caller error.
+//
+// Fails with ErrSeekBeforeStart if the resolved offset would be negative.
+// Fails with ErrCapacity if the resolved offset exceeds the capacity.
+func (rw *Wrapper) Seek(offset int64, whence int) (int64, error) {
+	current := int64(rw.offset)
+	capacity := int64(rw.Cap())
+	var resolved int64
+	switch whence {
+	case io.SeekStart:
+		resolved = offset
+	case io.SeekCurrent:
+		resolved = current + offset
+	case io.SeekEnd:
+		resolved = capacity + offset
+	default:
+		panic("bad whence value")
+	}
+
+	if resolved < 0 {
+		return current, ErrSeekBeforeStart
+	}
+	if resolved > capacity {
+		return current, ErrCapacity
+	}
+
+	rw.offset = int(resolved)
+	return resolved, nil
+}
+
+// Size returns the capacity of the wrapper as an int64, as required
+// by APIs that probe a stream's size via Seek(0, io.SeekEnd).
+func (rw *Wrapper) Size() int64 { return int64(rw.Cap()) }
+
+// SectionReader returns an *io.SectionReader over the whole content
+// of rw, independent of rw's own offset.
+func (rw *Wrapper) SectionReader() *io.SectionReader {
+	return io.NewSectionReader(rw.NewShared(), 0, rw.Size())
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 Giuseppe Calabrese.
+// Distributed under the terms of the ISC License.
+
+package sliceio_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/giucal/sliceio"
+)
+
+func TestSeekWhence(t *testing.T) {
+	w := sliceio.Wrap([]byte("0123456789"), 5)
+
+	cases := []struct {
+		offset int64
+		whence int
+		want   int64
+	}{
+		{3, io.SeekStart, 3},
+		{2, io.SeekCurrent, 5},
+		{0, io.SeekEnd, 10},
+		{-4, io.SeekEnd, 6},
+	}
+	for _, c := range cases {
+		got, err := w.Seek(c.offset, c.whence)
+		if err != nil {
+			t.Fatalf("Seek(%d, %d): %v", c.offset, c.whence, err)
+		}
+		if got != c.want {
+			t.Errorf("Seek(%d, %d) = %d, want %d", c.offset, c.whence, got, c.want)
+		}
+		if w.Offset() != int(c.want) {
+			t.Errorf("got Offset() = %d, want %d", w.Offset(), c.want)
+		}
+	}
+}
+
+func TestSeekBeforeStart(t *testing.T) {
+	w := sliceio.Wrap([]byte("0123456789"), 3)
+
+	if _, err := w.Seek(-1, io.SeekStart); err != sliceio.ErrSeekBeforeStart {
+		t.Fatalf("got err %v, want ErrSeekBeforeStart", err)
+	}
+	if w.Offset() != 3 {
+		t.Fatalf("failed Seek moved the offset to %d, want unchanged 3", w.Offset())
+	}
+}
+
+func TestSeekPastCapacity(t *testing.T) {
+	w := sliceio.Wrap([]byte("0123456789"), 3)
+
+	if _, err := w.Seek(1, io.SeekEnd); err != sliceio.ErrCapacity {
+		t.Fatalf("got err %v, want ErrCapacity", err)
+	}
+	if w.Offset() != 3 {
+		t.Fatalf("failed Seek moved the offset to %d, want unchanged 3", w.Offset())
+	}
+}
+
+func TestSize(t *testing.T) {
+	w := sliceio.New(7)
+	if w.Size() != 7 {
+		t.Fatalf("got Size() = %d, want 7", w.Size())
+	}
+}
+
+func TestSectionReader(t *testing.T) {
+	w := sliceio.Wrap([]byte("hello world"), 4)
+
+	sr := w.SectionReader()
+	if sr.Size() != int64(w.Cap()) {
+		t.Fatalf("got sr.Size() = %d, want %d", sr.Size(), w.Cap())
+	}
+
+	// The section reader has its own offset, starting at 0, unaffected
+	// by w's current offset.
+	buf := make([]byte, 5)
+	if _, err := sr.Read(buf); err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if string(buf) != "hello" {
+		t.Fatalf("got %q, want %q", buf, "hello")
+	}
+	if w.Offset() != 4 {
+		t.Fatalf("SectionReader.Read perturbed w.Offset(): got %d, want 4", w.Offset())
+	}
+}
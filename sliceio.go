@@ -146,13 +146,17 @@ var ErrOffsetExceedsMaxInt = errors.New("offset does not fit into an int")
 // the start.
 var ErrSeekBeforeStart = errors.New("seek before the start")
 
-// Seek sets the read/write offset.
+// SeekU sets the read/write offset.
+//
+// It is the uint64-based counterpart of Seek, from before Wrapper
+// implemented io.Seeker; it remains for callers that would otherwise
+// have to guard against negative offsets themselves.
 //
 // Fails with ErrSeekBeforeStart if the resolved offset would be negative.
 // Fails with ErrCapacity if the resolved offset exceeds the capacity.
 // Fails with ErrOffsetExceedsMaxInt if the resolved offset exceeds
 // the maximum representable capacity of a slice (i.e. math.MaxInt).
-func (rw *Wrapper) Seek(offset uint64, whence int) (uint64, error) {
+func (rw *Wrapper) SeekU(offset uint64, whence int) (uint64, error) {
 	current := uint64(rw.offset) // rw.offset >= 0
 	capacity := uint64(rw.Cap()) // Cap() >= 0
 	var resolved uint64
@@ -184,7 +188,7 @@ func (rw *Wrapper) Seek(offset uint64, whence int) (uint64, error) {
 
 // Rewind seeks to the start.
 func (rw *Wrapper) Rewind() {
-	rw.Seek(0, io.SeekStart)
+	rw.SeekU(0, io.SeekStart)
 }
 
 // Other I/O stuff.
@@ -0,0 +1,61 @@
+// Copyright 2022 Giuseppe Calabrese.
+// Distributed under the terms of the ISC License.
+
+package sliceio
+
+import "io"
+
+// Splice moves up to n bytes from src's current offset to dst's
+// current offset, using a single copy over the underlying slices —
+// no intermediate buffer, no allocation. It advances both offsets by
+// the number of bytes transferred, mirroring the splice/tee pattern
+// used to move bytes between file-like endpoints without copying
+// through a user buffer.
+//
+// dst and src may share the same underlying array, at any overlap —
+// including being the same *Wrapper, in which case the offset
+// advances by the number of bytes transferred exactly once. The builtin copy is defined in terms
+// of memmove and already handles every direction of overlap
+// correctly, so there is no separate error case for it.
+//
+// Fails with ErrCapacity if dst cannot fit n bytes, and with io.EOF
+// if src has fewer than n bytes left; in both cases, as many bytes
+// as possible are still transferred.
+func Splice(dst, src *Wrapper, n int) (int, error) {
+	dstRest := dst.RestLen()
+	srcRest := src.RestLen()
+
+	limit := n
+	if dstRest < limit {
+		limit = dstRest
+	}
+	if srcRest < limit {
+		limit = srcRest
+	}
+
+	m := copy(dst.slice[dst.offset:dst.offset+limit], src.slice[src.offset:src.offset+limit])
+	if dst == src {
+		dst.offset += m
+	} else {
+		dst.offset += m
+		src.offset += m
+	}
+
+	if m < n {
+		if dstRest < n {
+			return m, ErrCapacity
+		}
+		return m, io.EOF
+	}
+	return m, nil
+}
+
+// SpliceFrom moves up to n bytes from src into dst. It is Splice(dst, src, n).
+func (dst *Wrapper) SpliceFrom(src *Wrapper, n int) (int, error) {
+	return Splice(dst, src, n)
+}
+
+// SpliceTo moves up to n bytes from src into dst. It is Splice(dst, src, n).
+func (src *Wrapper) SpliceTo(dst *Wrapper, n int) (int, error) {
+	return Splice(dst, src, n)
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 Giuseppe Calabrese.
+// Distributed under the terms of the ISC License.
+
+package sliceio_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/giucal/sliceio"
+)
+
+func TestSpliceNonOverlapping(t *testing.T) {
+	src := sliceio.Wrap([]byte("hello"), 0)
+	dst := sliceio.New(5)
+
+	n, err := sliceio.Splice(dst, src, 5)
+	if err != nil {
+		t.Fatalf("Splice: %v", err)
+	}
+	if n != 5 {
+		t.Fatalf("got n = %d, want 5", n)
+	}
+	if string(dst.Content()) != "hello" {
+		t.Fatalf("got dst.Content() = %q, want %q", dst.Content(), "hello")
+	}
+	if src.Offset() != 5 || dst.Offset() != 5 {
+		t.Fatalf("got src.Offset() = %d, dst.Offset() = %d, want 5, 5", src.Offset(), dst.Offset())
+	}
+}
+
+func TestSpliceOverlapping(t *testing.T) {
+	buf := []byte("abcdefghij")
+	w := sliceio.Wrap(buf, 0)
+
+	// Splice the first half over the second half, through two
+	// wrappers that share buf but have independent offsets.
+	dst := w.View()
+	dst.SeekU(5, io.SeekStart)
+	src := w.View()
+
+	n, err := sliceio.Splice(dst, src, 5)
+	if err != nil {
+		t.Fatalf("Splice: %v", err)
+	}
+	if n != 5 {
+		t.Fatalf("got n = %d, want 5", n)
+	}
+	if string(buf) != "abcdeabcde" {
+		t.Fatalf("got buf = %q, want %q", buf, "abcdeabcde")
+	}
+}
+
+func TestSpliceSelf(t *testing.T) {
+	w := sliceio.Wrap([]byte("hello world"), 0)
+
+	n, err := sliceio.Splice(w, w, 3)
+	if err != nil {
+		t.Fatalf("Splice: %v", err)
+	}
+	if n != 3 {
+		t.Fatalf("got n = %d, want 3", n)
+	}
+	if w.Offset() != 3 {
+		t.Fatalf("got offset %d, want 3 (advanced once, not twice)", w.Offset())
+	}
+}
+
+func TestSpliceCapacity(t *testing.T) {
+	dst := sliceio.New(2)
+	src := sliceio.Wrap([]byte("hello"), 0)
+
+	n, err := sliceio.Splice(dst, src, 5)
+	if err != sliceio.ErrCapacity {
+		t.Fatalf("got err %v, want ErrCapacity", err)
+	}
+	if n != 2 {
+		t.Fatalf("got n = %d, want 2 (as many bytes as fit)", n)
+	}
+}
+
+func TestSpliceShortSource(t *testing.T) {
+	dst := sliceio.New(5)
+	src := sliceio.Wrap([]byte("hi"), 0)
+
+	n, err := dst.SpliceFrom(src, 5)
+	if err != io.EOF {
+		t.Fatalf("got err %v, want io.EOF", err)
+	}
+	if n != 2 {
+		t.Fatalf("got n = %d, want 2 (as many bytes as src had)", n)
+	}
+}
+
+func TestSpliceTo(t *testing.T) {
+	src := sliceio.Wrap([]byte("hey"), 0)
+	dst := sliceio.New(3)
+
+	n, err := src.SpliceTo(dst, 3)
+	if err != nil {
+		t.Fatalf("SpliceTo: %v", err)
+	}
+	if n != 3 || string(dst.Content()) != "hey" {
+		t.Fatalf("got n = %d, dst.Content() = %q", n, dst.Content())
+	}
+}